@@ -9,25 +9,46 @@ import (
 	"runtime"
 )
 
+// AppendAttrFromContextFunc extracts attrs (request ID, tenant, trace ID, ...)
+// from a context for ContextHandler to attach to every record logged with
+// it. See TraceAttrs for a ready-made one that decorates records with
+// trace/span IDs.
+type AppendAttrFromContextFunc func(ctx context.Context) ([]slog.Attr, error)
+
 type ContextHandlerOptions struct {
-	UseJson               bool
-	Level                 slog.Level
-	AddSource             bool
-	SourceKey             string
-	Writer                io.Writer
-	WithPID               bool   // append pid to the log
-	PIDKey                string // the pid key name in the log
-	CallerSkip            int
-	ExtraAttrs            []slog.Attr
-	AppendAttrFromContext func(ctx context.Context) ([]slog.Attr, error)
+	UseJson bool
+	// Level is the minimum level the handler logs at. Pass a *slog.LevelVar
+	// (see NewLevelVar) instead of a plain slog.Level to change the
+	// threshold at runtime without rebuilding the handler.
+	Level      slog.Leveler
+	AddSource  bool
+	SourceKey  string
+	Writer     io.Writer
+	WithPID    bool   // append pid to the log
+	PIDKey     string // the pid key name in the log
+	CallerSkip int
+	ExtraAttrs []slog.Attr
+	// AppendAttrFromContext runs every func in order and merges their attrs,
+	// so e.g. a request-ID extractor and TraceAttrs can be registered side
+	// by side. Defaults to a single func reading whatever was stashed on the
+	// context via WithValue/WithAttrs.
+	AppendAttrFromContext []AppendAttrFromContextFunc
+}
+
+// groupOrAttrs is one WithGroup or WithAttrs call deferred until Handle,
+// recorded in the order it was made.
+type groupOrAttrs struct {
+	group string      // group name, set if this frame is a WithGroup call
+	attrs []slog.Attr // set if this frame is a WithAttrs call
 }
 
 type ContextHandler struct {
-	slog.Handler
+	handler               slog.Handler // the wrapper's own WithGroup/WithAttrs calls are kept in goas, not applied here
+	goas                  []groupOrAttrs
 	AddSource             bool
 	SourceKey             string
 	CallerSkip            int
-	AppendAttrFromContext func(ctx context.Context) ([]slog.Attr, error)
+	AppendAttrFromContext []AppendAttrFromContextFunc
 }
 
 func NewContextHandler(options ContextHandlerOptions) *ContextHandler {
@@ -65,17 +86,40 @@ func NewContextHandler(options ContextHandlerOptions) *ContextHandler {
 		handler = handler.WithAttrs(options.ExtraAttrs)
 	}
 
+	appendAttrFromContext := options.AppendAttrFromContext
+	if len(appendAttrFromContext) == 0 {
+		// Default to reading whatever was stashed on the context via
+		// WithValue/WithAttrs, so request-scoped fields show up without
+		// every caller having to write their own context plumbing.
+		appendAttrFromContext = []AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return FromContext(ctx), nil
+			},
+		}
+	}
+
 	return &ContextHandler{
-		Handler:               handler,
+		handler:               handler,
 		AddSource:             options.AddSource,
 		SourceKey:             options.SourceKey,
 		CallerSkip:            options.CallerSkip,
-		AppendAttrFromContext: options.AppendAttrFromContext,
+		AppendAttrFromContext: appendAttrFromContext,
 	}
 }
 
-// Append different fields according to the context
-func (h ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+// Enabled reports whether the underlying handler is enabled for level.
+func (h *ContextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+// Handle adds source/context attributes at the top level, then replays any
+// WithGroup/WithAttrs calls recorded on the wrapper so that user groups
+// still nest correctly. Doing the replay here (rather than eagerly calling
+// WithGroup/WithAttrs on the underlying handler as each is made) is what
+// keeps source/ctx attrs out of the innermost group.
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	var topLevelAttrs []slog.Attr
+
 	if h.AddSource {
 		skip := h.CallerSkip
 		if skip == 0 {
@@ -91,47 +135,59 @@ func (h ContextHandler) Handle(ctx context.Context, r slog.Record) error {
 				sourceKey = h.SourceKey
 			}
 
-			r.AddAttrs(slog.String(sourceKey, source))
+			topLevelAttrs = append(topLevelAttrs, slog.String(sourceKey, source))
 		}
 	}
 
-	if ctx == context.Background() {
-		return h.Handler.Handle(ctx, r)
+	if ctx != context.Background() {
+		for _, appendAttrs := range h.AppendAttrFromContext {
+			attrs, err := appendAttrs(ctx)
+			if err != nil {
+				slog.Error("failed to append attributes from context", slog.String("error", err.Error()))
+				continue
+			}
+			topLevelAttrs = append(topLevelAttrs, attrs...)
+		}
 	}
 
-	if h.AppendAttrFromContext != nil {
-		attrs, err := h.AppendAttrFromContext(ctx)
-		if err != nil {
-			slog.Error("failed to append attributes from context", slog.String("error", err.Error()))
+	handler := h.handler
+	if len(topLevelAttrs) > 0 {
+		handler = handler.WithAttrs(topLevelAttrs)
+	}
+
+	for _, goa := range h.goas {
+		if goa.group != "" {
+			handler = handler.WithGroup(goa.group)
 		} else {
-			r.AddAttrs(attrs...)
+			handler = handler.WithAttrs(goa.attrs)
 		}
-
 	}
 
-	return h.Handler.Handle(ctx, r)
+	return handler.Handle(ctx, r)
 }
 
 // WithAttrs returns a new handler with the given attributes added to all records.
 // This ensures the ContextHandler wrapper is preserved when .With() is called on the logger.
-func (h ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
-	return ContextHandler{
-		Handler:               h.Handler.WithAttrs(attrs),
-		AddSource:             h.AddSource,
-		SourceKey:             h.SourceKey,
-		CallerSkip:            h.CallerSkip,
-		AppendAttrFromContext: h.AppendAttrFromContext,
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
 	}
+	return h.withGroupOrAttrs(groupOrAttrs{attrs: attrs})
 }
 
 // WithGroup returns a new handler that starts a group with the given name.
 // This ensures the ContextHandler wrapper is preserved when grouping is used.
-func (h ContextHandler) WithGroup(name string) slog.Handler {
-	return ContextHandler{
-		Handler:               h.Handler.WithGroup(name),
-		AddSource:             h.AddSource,
-		SourceKey:             h.SourceKey,
-		CallerSkip:            h.CallerSkip,
-		AppendAttrFromContext: h.AppendAttrFromContext,
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
 	}
+	return h.withGroupOrAttrs(groupOrAttrs{group: name})
+}
+
+func (h *ContextHandler) withGroupOrAttrs(goa groupOrAttrs) *ContextHandler {
+	h2 := *h
+	h2.goas = make([]groupOrAttrs, len(h.goas)+1)
+	copy(h2.goas, h.goas)
+	h2.goas[len(h.goas)] = goa
+	return &h2
 }
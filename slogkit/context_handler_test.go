@@ -187,8 +187,10 @@ func (s *ContextHandlerSuite) TestHandle_BackgroundContext() {
 		UseJson: true,
 		Writer:  s.buf,
 		Level:   slog.LevelInfo,
-		AppendAttrFromContext: func(ctx context.Context) ([]slog.Attr, error) {
-			return []slog.Attr{slog.String("from_context", "value")}, nil
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return []slog.Attr{slog.String("from_context", "value")}, nil
+			},
 		},
 	})
 
@@ -207,11 +209,13 @@ func (s *ContextHandlerSuite) TestHandle_WithAppendAttrFromContext() {
 		UseJson: true,
 		Writer:  s.buf,
 		Level:   slog.LevelInfo,
-		AppendAttrFromContext: func(ctx context.Context) ([]slog.Attr, error) {
-			return []slog.Attr{
-				slog.String("request_id", "12345"),
-				slog.String("user_id", "user-1"),
-			}, nil
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return []slog.Attr{
+					slog.String("request_id", "12345"),
+					slog.String("user_id", "user-1"),
+				}, nil
+			},
 		},
 	})
 
@@ -233,11 +237,13 @@ func (s *ContextHandlerSuite) TestHandle_AppendAttrFromContextError() {
 		UseJson: true,
 		Writer:  s.buf,
 		Level:   slog.LevelInfo,
-		AppendAttrFromContext: func(ctx context.Context) ([]slog.Attr, error) {
-			return []slog.Attr{
-				slog.String("request_id", "12345"),
-				slog.String("user_id", "user-1"),
-			}, testErr
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return []slog.Attr{
+					slog.String("request_id", "12345"),
+					slog.String("user_id", "user-1"),
+				}, testErr
+			},
 		},
 	})
 
@@ -326,8 +332,10 @@ func (s *ContextHandlerSuite) TestHandle_AllFeatures() {
 		ExtraAttrs: []slog.Attr{
 			slog.String("service", "test"),
 		},
-		AppendAttrFromContext: func(ctx context.Context) ([]slog.Attr, error) {
-			return []slog.Attr{slog.String("request_id", "req-123")}, nil
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return []slog.Attr{slog.String("request_id", "req-123")}, nil
+			},
 		},
 	})
 
@@ -368,6 +376,39 @@ func (s *ContextHandlerSuite) TestHandle_WithAttrs() {
 	s.Contains(jsonData, "source")
 }
 
+// WithGroup should not nest source/context attrs added later inside Handle
+func (s *ContextHandlerSuite) TestHandle_SourceStaysAtRootInsideGroup() {
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson:   true,
+		Writer:    s.buf,
+		Level:     slog.LevelInfo,
+		AddSource: true,
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			func(ctx context.Context) ([]slog.Attr, error) {
+				return []slog.Attr{slog.String("request_id", "12345")}, nil
+			},
+		},
+	})
+
+	logger := slog.New(handler).WithGroup("req").With("id", 1)
+	logger.InfoContext(context.WithValue(context.Background(), reqIdCtxKey{}, "12345"), "test message")
+
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(s.buf.Bytes(), &jsonData)
+	s.NoError(err)
+
+	// source and context attrs are cross-cutting: they stay at the root
+	s.Contains(jsonData, "source")
+	s.Contains(jsonData, "request_id")
+
+	// attrs added via With() after WithGroup() stay nested in the group
+	req, ok := jsonData["req"].(map[string]interface{})
+	s.Require().True(ok)
+	s.Equal(float64(1), req["id"])
+	s.NotContains(req, "source")
+	s.NotContains(req, "request_id")
+}
+
 func TestContextHandlerSuite(t *testing.T) {
 	suite.Run(t, new(ContextHandlerSuite))
 }
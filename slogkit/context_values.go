@@ -0,0 +1,62 @@
+package slogkit
+
+import (
+	"context"
+	"log/slog"
+)
+
+type ctxAttrsKey struct{}
+
+// ctxAttrs is an immutable, singly-linked chain of attributes attached to a
+// context. WithValue and WithAttrs always append a new node pointing at the
+// previous one instead of mutating it, so a child context can never leak its
+// attributes back into the parent context it was derived from.
+type ctxAttrs struct {
+	parent *ctxAttrs
+	attrs  []slog.Attr
+}
+
+// WithValue returns a copy of ctx carrying an additional key/value pair.
+// ContextHandler's default AppendAttrFromContext reads these values back out
+// via FromContext and attaches them to every record logged with the returned
+// context (or any context derived from it). It does not affect ctx itself or
+// any other context derived from it.
+func WithValue(ctx context.Context, key string, val any) context.Context {
+	return WithAttrs(ctx, slog.Any(key, val))
+}
+
+// WithAttrs returns a copy of ctx carrying additional attributes.
+// ContextHandler's default AppendAttrFromContext reads these attrs back out
+// via FromContext and attaches them to every record logged with the returned
+// context (or any context derived from it). It does not affect ctx itself or
+// any other context derived from it.
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+
+	parent, _ := ctx.Value(ctxAttrsKey{}).(*ctxAttrs)
+	return context.WithValue(ctx, ctxAttrsKey{}, &ctxAttrs{parent: parent, attrs: attrs})
+}
+
+// FromContext returns the attributes accumulated on ctx via WithValue and
+// WithAttrs, outermost first, so attributes added by an inner scope come
+// last and take precedence over those added by an outer one in handlers
+// that apply last-wins semantics.
+func FromContext(ctx context.Context) []slog.Attr {
+	node, _ := ctx.Value(ctxAttrsKey{}).(*ctxAttrs)
+	if node == nil {
+		return nil
+	}
+
+	var chain []*ctxAttrs
+	for n := node; n != nil; n = n.parent {
+		chain = append(chain, n)
+	}
+
+	var attrs []slog.Attr
+	for i := len(chain) - 1; i >= 0; i-- {
+		attrs = append(attrs, chain[i].attrs...)
+	}
+	return attrs
+}
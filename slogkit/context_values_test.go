@@ -0,0 +1,80 @@
+package slogkit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/entrylens/godash/slogkit"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContextValuesSuite struct {
+	suite.Suite
+	buf *bytes.Buffer
+}
+
+func (s *ContextValuesSuite) SetupTest() {
+	s.buf = &bytes.Buffer{}
+}
+
+// FromContext should return nil for a context with no attached attributes
+func (s *ContextValuesSuite) TestFromContext_Empty() {
+	s.Nil(slogkit.FromContext(context.Background()))
+}
+
+// WithValue should make the key/value pair observable via FromContext
+func (s *ContextValuesSuite) TestWithValue_FromContext() {
+	ctx := slogkit.WithValue(context.Background(), "request_id", "12345")
+
+	attrs := slogkit.FromContext(ctx)
+	s.Require().Len(attrs, 1)
+	s.Equal("request_id", attrs[0].Key)
+	s.Equal("12345", attrs[0].Value.String())
+}
+
+// WithAttrs should accumulate across nested calls, outermost first
+func (s *ContextValuesSuite) TestWithAttrs_Accumulates() {
+	ctx := slogkit.WithAttrs(context.Background(), slog.String("tenant", "acme"))
+	ctx = slogkit.WithAttrs(ctx, slog.String("user_id", "user-1"), slog.Int("attempt", 2))
+
+	attrs := slogkit.FromContext(ctx)
+	s.Require().Len(attrs, 3)
+	s.Equal("tenant", attrs[0].Key)
+	s.Equal("user_id", attrs[1].Key)
+	s.Equal("attempt", attrs[2].Key)
+}
+
+// WithValue on a child context must not leak attributes back into the parent
+func (s *ContextValuesSuite) TestWithValue_ChildDoesNotLeakIntoParent() {
+	parent := slogkit.WithValue(context.Background(), "request_id", "12345")
+	child := slogkit.WithValue(parent, "user_id", "user-1")
+
+	s.Len(slogkit.FromContext(parent), 1)
+	s.Len(slogkit.FromContext(child), 2)
+}
+
+// ContextHandler should pick up WithValue/WithAttrs fields by default, with
+// no AppendAttrFromContext configured
+func (s *ContextValuesSuite) TestContextHandler_DefaultAppendAttrFromContext() {
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson: true,
+		Writer:  s.buf,
+		Level:   slog.LevelInfo,
+	})
+
+	logger := slog.New(handler)
+	ctx := slogkit.WithValue(context.Background(), "request_id", "12345")
+	logger.InfoContext(ctx, "test message")
+
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(s.buf.Bytes(), &jsonData)
+	s.NoError(err)
+	s.Equal("12345", jsonData["request_id"])
+}
+
+func TestContextValuesSuite(t *testing.T) {
+	suite.Run(t, new(ContextValuesSuite))
+}
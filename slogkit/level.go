@@ -0,0 +1,49 @@
+package slogkit
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// NewLevelVar returns a *slog.LevelVar initialized to level, for passing as
+// ContextHandlerOptions.Level when the logging threshold needs to change at
+// runtime (an admin endpoint, a SIGUSR handler, ...) without rebuilding the
+// handler and losing accumulated WithAttrs/WithGroup state.
+func NewLevelVar(level slog.Level) *slog.LevelVar {
+	var v slog.LevelVar
+	v.Set(level)
+	return &v
+}
+
+// LevelHandler returns an http.Handler that reports v's current level on GET
+// and updates it on PUT, parsing the request body as a standard slog level
+// name (DEBUG, INFO, WARN, ERROR, optionally with a "+n"/"-n" offset).
+func LevelHandler(v *slog.LevelVar) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprintln(w, v.Level())
+
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			var level slog.Level
+			if err := level.UnmarshalText(body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			v.Set(level)
+			fmt.Fprintln(w, v.Level())
+
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package slogkit_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/entrylens/godash/slogkit"
+	"github.com/stretchr/testify/suite"
+)
+
+type LevelSuite struct {
+	suite.Suite
+	buf *bytes.Buffer
+}
+
+func (s *LevelSuite) SetupTest() {
+	s.buf = &bytes.Buffer{}
+}
+
+// NewContextHandler should respect a LevelVar and react to changes made after construction
+func (s *LevelSuite) TestNewContextHandler_LevelVarIsLive() {
+	levelVar := slogkit.NewLevelVar(slog.LevelWarn)
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson: false,
+		Writer:  s.buf,
+		Level:   levelVar,
+	})
+
+	logger := slog.New(handler)
+	logger.Info("info message")
+	s.NotContains(s.buf.String(), "info message")
+
+	levelVar.Set(slog.LevelInfo)
+	logger.Info("info message")
+	s.Contains(s.buf.String(), "info message")
+}
+
+// LevelHandler should report the current level on GET
+func (s *LevelSuite) TestLevelHandler_Get() {
+	levelVar := slogkit.NewLevelVar(slog.LevelWarn)
+	handler := slogkit.LevelHandler(levelVar)
+
+	req := httptest.NewRequest(http.MethodGet, "/level", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal("WARN", strings.TrimSpace(rec.Body.String()))
+}
+
+// LevelHandler should update the level on PUT
+func (s *LevelSuite) TestLevelHandler_Put() {
+	levelVar := slogkit.NewLevelVar(slog.LevelInfo)
+	handler := slogkit.LevelHandler(levelVar)
+
+	req := httptest.NewRequest(http.MethodPut, "/level", strings.NewReader("DEBUG"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusOK, rec.Code)
+	s.Equal(slog.LevelDebug, levelVar.Level())
+}
+
+// LevelHandler should reject an unparseable level
+func (s *LevelSuite) TestLevelHandler_PutInvalidLevel() {
+	levelVar := slogkit.NewLevelVar(slog.LevelInfo)
+	handler := slogkit.LevelHandler(levelVar)
+
+	req := httptest.NewRequest(http.MethodPut, "/level", strings.NewReader("NOT_A_LEVEL"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusBadRequest, rec.Code)
+	s.Equal(slog.LevelInfo, levelVar.Level())
+}
+
+// LevelHandler should reject other HTTP methods
+func (s *LevelSuite) TestLevelHandler_MethodNotAllowed() {
+	handler := slogkit.LevelHandler(slogkit.NewLevelVar(slog.LevelInfo))
+
+	req := httptest.NewRequest(http.MethodPost, "/level", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	s.Equal(http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestLevelSuite(t *testing.T) {
+	suite.Run(t, new(LevelSuite))
+}
@@ -0,0 +1,64 @@
+package slogkit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// MultiHandler broadcasts every slog.Handler call to a set of wrapped
+// handlers, e.g. pretty text to stderr alongside JSON to a file. A record is
+// considered handled if any child handler is enabled for it, and an error
+// from one child does not stop the others from receiving the record.
+type MultiHandler struct {
+	handlers []slog.Handler
+}
+
+// NewMultiHandler returns a slog.Handler that fans every call out to each of
+// handlers.
+func NewMultiHandler(handlers ...slog.Handler) *MultiHandler {
+	return &MultiHandler{handlers: handlers}
+}
+
+// Enabled reports whether any wrapped handler is enabled for the given level.
+func (h *MultiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h.handlers {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle passes the record to every wrapped handler, even if one of them
+// errors, and joins any errors returned.
+func (h *MultiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, handler := range h.handlers {
+		if !handler.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := handler.Handle(ctx, r.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithAttrs returns a new MultiHandler with attrs added to every wrapped handler.
+func (h *MultiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make([]slog.Handler, len(h.handlers))
+	for i, handler := range h.handlers {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return &MultiHandler{handlers: handlers}
+}
+
+// WithGroup returns a new MultiHandler with the group started on every wrapped handler.
+func (h *MultiHandler) WithGroup(name string) slog.Handler {
+	handlers := make([]slog.Handler, len(h.handlers))
+	for i, handler := range h.handlers {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return &MultiHandler{handlers: handlers}
+}
@@ -0,0 +1,87 @@
+package slogkit_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/entrylens/godash/slogkit"
+	"github.com/stretchr/testify/suite"
+)
+
+type erroringHandler struct {
+	slog.Handler
+	err error
+}
+
+func (h erroringHandler) Handle(ctx context.Context, r slog.Record) error {
+	_ = h.Handler.Handle(ctx, r)
+	return h.err
+}
+
+type MultiHandlerSuite struct {
+	suite.Suite
+	bufA *bytes.Buffer
+	bufB *bytes.Buffer
+}
+
+func (s *MultiHandlerSuite) SetupTest() {
+	s.bufA = &bytes.Buffer{}
+	s.bufB = &bytes.Buffer{}
+}
+
+// Handle should write the record to every wrapped handler
+func (s *MultiHandlerSuite) TestHandle_BroadcastsToAll() {
+	handlerA := slog.NewJSONHandler(s.bufA, nil)
+	handlerB := slog.NewJSONHandler(s.bufB, nil)
+	multi := slogkit.NewMultiHandler(handlerA, handlerB)
+
+	logger := slog.New(multi)
+	logger.Info("test message")
+
+	s.Contains(s.bufA.String(), "test message")
+	s.Contains(s.bufB.String(), "test message")
+}
+
+// Handle should collect errors from faulty handlers without skipping the others
+func (s *MultiHandlerSuite) TestHandle_JoinsErrorsWithoutShortCircuiting() {
+	faultyErr := errors.New("sink unavailable")
+	faulty := erroringHandler{Handler: slog.NewJSONHandler(s.bufA, nil), err: faultyErr}
+	healthy := slog.NewJSONHandler(s.bufB, nil)
+	multi := slogkit.NewMultiHandler(faulty, healthy)
+
+	r := slog.NewRecord(time.Now(), slog.LevelInfo, "test message", 0)
+	err := multi.Handle(context.Background(), r)
+
+	s.ErrorIs(err, faultyErr)
+	s.Contains(s.bufB.String(), "test message")
+}
+
+// Enabled should return true if any wrapped handler is enabled
+func (s *MultiHandlerSuite) TestEnabled_TrueIfAnyChildEnabled() {
+	debugHandler := slog.NewJSONHandler(s.bufA, &slog.HandlerOptions{Level: slog.LevelDebug})
+	warnHandler := slog.NewJSONHandler(s.bufB, &slog.HandlerOptions{Level: slog.LevelWarn})
+	multi := slogkit.NewMultiHandler(warnHandler, debugHandler)
+
+	s.True(multi.Enabled(context.Background(), slog.LevelDebug))
+}
+
+// WithAttrs should apply to every wrapped handler
+func (s *MultiHandlerSuite) TestWithAttrs_AppliesToAllChildren() {
+	handlerA := slog.NewJSONHandler(s.bufA, nil)
+	handlerB := slog.NewJSONHandler(s.bufB, nil)
+	multi := slogkit.NewMultiHandler(handlerA, handlerB)
+
+	logger := slog.New(multi.WithAttrs([]slog.Attr{slog.String("service", "test")}))
+	logger.Info("test message")
+
+	s.Contains(s.bufA.String(), "service")
+	s.Contains(s.bufB.String(), "service")
+}
+
+func TestMultiHandlerSuite(t *testing.T) {
+	suite.Run(t, new(MultiHandlerSuite))
+}
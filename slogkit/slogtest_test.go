@@ -0,0 +1,44 @@
+package slogkit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"testing/slogtest"
+
+	"github.com/entrylens/godash/slogkit"
+)
+
+// TestContextHandler_SlogtestConformance runs the stdlib handler conformance
+// suite against ContextHandler (JSON mode, no source/PID/context attrs so
+// the suite can reason about exactly the attrs it logs) so ContextHandler
+// stays a drop-in replacement for slog.JSONHandler rather than a subtly
+// non-conforming wrapper.
+func TestContextHandler_SlogtestConformance(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson: true,
+		Writer:  &buf,
+		Level:   slog.LevelDebug,
+	})
+
+	results := func() []map[string]any {
+		var records []map[string]any
+		for _, line := range bytes.Split(bytes.TrimRight(buf.Bytes(), "\n"), []byte("\n")) {
+			if len(line) == 0 {
+				continue
+			}
+			var record map[string]any
+			if err := json.Unmarshal(line, &record); err != nil {
+				t.Fatalf("unmarshal log line: %v", err)
+			}
+			records = append(records, record)
+		}
+		return records
+	}
+
+	if err := slogtest.TestHandler(handler, results); err != nil {
+		t.Error(err)
+	}
+}
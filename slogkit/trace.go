@@ -0,0 +1,57 @@
+package slogkit
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceAttrs returns an AppendAttrFromContextFunc that calls extractor on ctx
+// and, if a trace ID was found, attaches trace_id/span_id/trace_sampled
+// attrs in the shape most APM backends expect. Register it alongside other
+// AppendAttrFromContextFunc values (e.g. a request-ID extractor) on
+// ContextHandlerOptions.AppendAttrFromContext to compose them.
+func TraceAttrs(extractor func(ctx context.Context) (traceID, spanID string, sampled bool)) AppendAttrFromContextFunc {
+	return func(ctx context.Context) ([]slog.Attr, error) {
+		traceID, spanID, sampled := extractor(ctx)
+		if traceID == "" {
+			return nil, nil
+		}
+
+		attrs := []slog.Attr{slog.String("trace_id", traceID)}
+		if spanID != "" {
+			attrs = append(attrs, slog.String("span_id", spanID))
+		}
+		attrs = append(attrs, slog.Bool("trace_sampled", sampled))
+		return attrs, nil
+	}
+}
+
+// OTelTraceExtractor extracts the trace/span ID of the OpenTelemetry span
+// stored on ctx (e.g. via trace.ContextWithSpan), for use with TraceAttrs.
+func OTelTraceExtractor(ctx context.Context) (traceID, spanID string, sampled bool) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return "", "", false
+	}
+	return sc.TraceID().String(), sc.SpanID().String(), sc.IsSampled()
+}
+
+// TraceParentExtractor returns an extractor, for use with TraceAttrs, that
+// reads a W3C traceparent header value (e.g.
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01") stored on ctx
+// under key and parses it into a trace ID, span ID, and sampled flag.
+func TraceParentExtractor(key any) func(ctx context.Context) (traceID, spanID string, sampled bool) {
+	return func(ctx context.Context) (string, string, bool) {
+		raw, _ := ctx.Value(key).(string)
+
+		parts := strings.Split(raw, "-")
+		if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+			return "", "", false
+		}
+
+		return parts[1], parts[2], parts[3] == "01"
+	}
+}
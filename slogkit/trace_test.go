@@ -0,0 +1,120 @@
+package slogkit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/entrylens/godash/slogkit"
+	"github.com/stretchr/testify/suite"
+)
+
+type traceParentCtxKey struct{}
+
+type TraceSuite struct {
+	suite.Suite
+	buf *bytes.Buffer
+}
+
+func (s *TraceSuite) SetupTest() {
+	s.buf = &bytes.Buffer{}
+}
+
+// TraceAttrs should attach trace_id/span_id/trace_sampled when the extractor finds a trace
+func (s *TraceSuite) TestTraceAttrs_AttachesFields() {
+	extractor := func(ctx context.Context) (string, string, bool) {
+		return "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7", true
+	}
+
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson:               true,
+		Writer:                s.buf,
+		Level:                 slog.LevelInfo,
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{slogkit.TraceAttrs(extractor)},
+	})
+
+	logger := slog.New(handler)
+	logger.InfoContext(context.WithValue(context.Background(), traceParentCtxKey{}, "present"), "test message")
+
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(s.buf.Bytes(), &jsonData)
+	s.NoError(err)
+	s.Equal("4bf92f3577b34da6a3ce929d0e0e4736", jsonData["trace_id"])
+	s.Equal("00f067aa0ba902b7", jsonData["span_id"])
+	s.Equal(true, jsonData["trace_sampled"])
+}
+
+// TraceAttrs should add nothing when the extractor finds no trace
+func (s *TraceSuite) TestTraceAttrs_NoTraceFound() {
+	extractor := func(ctx context.Context) (string, string, bool) { return "", "", false }
+
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson:               true,
+		Writer:                s.buf,
+		Level:                 slog.LevelInfo,
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{slogkit.TraceAttrs(extractor)},
+	})
+
+	logger := slog.New(handler)
+	logger.InfoContext(context.WithValue(context.Background(), traceParentCtxKey{}, "noop"), "test message")
+
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(s.buf.Bytes(), &jsonData)
+	s.NoError(err)
+	s.NotContains(jsonData, "trace_id")
+}
+
+// TraceAttrs composes with other AppendAttrFromContextFunc values
+func (s *TraceSuite) TestTraceAttrs_ComposesWithOtherExtractors() {
+	requestIDFunc := func(ctx context.Context) ([]slog.Attr, error) {
+		return []slog.Attr{slog.String("request_id", "req-1")}, nil
+	}
+
+	handler := slogkit.NewContextHandler(slogkit.ContextHandlerOptions{
+		UseJson: true,
+		Writer:  s.buf,
+		Level:   slog.LevelInfo,
+		AppendAttrFromContext: []slogkit.AppendAttrFromContextFunc{
+			requestIDFunc,
+			slogkit.TraceAttrs(slogkit.TraceParentExtractor(traceParentCtxKey{})),
+		},
+	})
+
+	logger := slog.New(handler)
+	ctx := context.WithValue(context.Background(), traceParentCtxKey{}, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	logger.InfoContext(ctx, "test message")
+
+	var jsonData map[string]interface{}
+	err := json.Unmarshal(s.buf.Bytes(), &jsonData)
+	s.NoError(err)
+	s.Equal("req-1", jsonData["request_id"])
+	s.Equal("4bf92f3577b34da6a3ce929d0e0e4736", jsonData["trace_id"])
+}
+
+// TraceParentExtractor should parse a valid W3C traceparent header
+func (s *TraceSuite) TestTraceParentExtractor_ParsesValidHeader() {
+	extractor := slogkit.TraceParentExtractor(traceParentCtxKey{})
+	ctx := context.WithValue(context.Background(), traceParentCtxKey{}, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	traceID, spanID, sampled := extractor(ctx)
+	s.Equal("4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+	s.Equal("00f067aa0ba902b7", spanID)
+	s.True(sampled)
+}
+
+// TraceParentExtractor should reject a malformed header
+func (s *TraceSuite) TestTraceParentExtractor_RejectsMalformedHeader() {
+	extractor := slogkit.TraceParentExtractor(traceParentCtxKey{})
+	ctx := context.WithValue(context.Background(), traceParentCtxKey{}, "not-a-traceparent")
+
+	traceID, spanID, sampled := extractor(ctx)
+	s.Empty(traceID)
+	s.Empty(spanID)
+	s.False(sampled)
+}
+
+func TestTraceSuite(t *testing.T) {
+	suite.Run(t, new(TraceSuite))
+}